@@ -0,0 +1,44 @@
+package config
+
+// Version, CommitHash, Branch, and BuildTimestamp are stamped in at build
+// time via -ldflags and reported by getVersionInfo/getNodeInfo and the
+// build_info Prometheus gauge.
+var (
+	Version        string
+	CommitHash     string
+	Branch         string
+	BuildTimestamp string
+)
+
+// Config holds the runtime settings read from command-line flags and
+// environment variables.
+type Config struct {
+	// MempoolFeeBumpAfterLedgers is the number of ledgers a
+	// TXStatusTryAgainLater submission may remain unconfirmed before the
+	// local mempool escalates it to a fee-bump wrapper.
+	MempoolFeeBumpAfterLedgers uint32
+	// MempoolFeeBumpMultiplier is the factor applied to a stale
+	// transaction's original fee when constructing its fee-bump wrapper.
+	MempoolFeeBumpMultiplier uint32
+	// MempoolFeeBumpMaxFee caps the fee, in stroops, the mempool will ever
+	// pay for a fee-bump wrapper, regardless of MempoolFeeBumpMultiplier.
+	MempoolFeeBumpMaxFee int64
+	// MempoolFeeBumpSigningKey is the strkey-encoded secret seed used to
+	// sign fee-bump wrappers built by the local mempool.
+	MempoolFeeBumpSigningKey string
+
+	// TracingEnabled turns on OpenTelemetry tracing with a live OTLP
+	// exporter; when false, spans are created against a no-op tracer.
+	TracingEnabled bool
+	// TracingOTLPEndpoint is the OTLP/gRPC collector endpoint traces are
+	// exported to when TracingEnabled is true.
+	TracingOTLPEndpoint string
+	// TracingSamplerRatio is the fraction, between 0 and 1, of traces that
+	// are sampled when TracingEnabled is true.
+	TracingSamplerRatio float64
+
+	// SendTransactionPreflightMode controls whether sendTransaction
+	// simulates Soroban transactions before forwarding them to hcnet-core.
+	// One of "off", "warn", or "reject"; see methods.PreflightMode.
+	SendTransactionPreflightMode string
+}
@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+)
+
+// SubmitResult is the outcome of a TransactionSubmitter.SubmitTransaction
+// call, augmented with the name of the backend that produced it so callers
+// can log or meter per-backend behavior.
+type SubmitResult struct {
+	*proto.TXResponse
+	// Backend identifies which submission backend produced this result,
+	// e.g. "core:0", "core:1", or "horizon".
+	Backend string
+}
+
+// TransactionSubmitter abstracts the backend(s) used to submit transactions
+// to the network. Implementations may wrap a single hcnet-core client, fan
+// submissions out to several cores in parallel, or fall back to Horizon's
+// HTTP submission endpoint when no core is reachable.
+type TransactionSubmitter interface {
+	SubmitTransaction(ctx context.Context, envelopeXdr string) (SubmitResult, error)
+	Info(ctx context.Context) (*proto.InfoResponse, error)
+}
+
+// CoreClient is retained as an alias of TransactionSubmitter so existing
+// callers referring to "the" hcnet-core client keep compiling as the rest
+// of the daemon migrates to backends that may not be a single core.
+type CoreClient = TransactionSubmitter
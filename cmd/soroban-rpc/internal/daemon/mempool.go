@@ -0,0 +1,316 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/HashCash-Consultants/go/keypair"
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+	"github.com/HashCash-Consultants/go/support/log"
+	"github.com/HashCash-Consultants/go/txnbuild"
+	"github.com/HashCash-Consultants/go/xdr"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/config"
+)
+
+// MempoolEntry is the tracked state of a single submitted transaction,
+// exposed via the getMempoolEntry JSON-RPC method.
+type MempoolEntry struct {
+	Hash            string
+	SourceAccount   string
+	SourceSequence  int64
+	Attempts        uint32
+	LastStatus      string
+	EffectiveFee    int64
+	FeeBumped       bool
+	FirstSeen       time.Time
+	NextRetry       time.Time
+}
+
+// Mempool is a local, in-memory tracker of submitted transaction envelopes,
+// each kept until Resolve is called for its hash. It deduplicates
+// resubmissions of the same envelope, resubmits TXStatusTryAgainLater
+// responses with exponential backoff, and escalates to a fee-bump wrapper
+// once a submission has sat unconfirmed for too long. It does not expire
+// entries on its own: resubmission does not stop at a transaction's time or
+// ledger bounds.
+type Mempool struct {
+	lock    sync.Mutex
+	entries map[string]*mempoolEntryInternal
+
+	submitter         *CoreClientWithMetrics
+	networkPassphrase string
+
+	// config knobs, see config.Config for the underlying flags.
+	maxRetryBackoff     time.Duration
+	feeBumpAfterLedgers uint32
+	feeBumpMultiplier   uint32
+	feeBumpMaxFee       int64
+	feeBumpSigningKey   string
+
+	depthGauge       prometheus.Gauge
+	ageHistogram     prometheus.Histogram
+	resubmitCounter  prometheus.Counter
+	feeBumpCounter   prometheus.Counter
+}
+
+type mempoolEntryInternal struct {
+	envelope       xdr.TransactionEnvelope
+	envelopeBase64 string
+	hash           string
+	sourceAccount  string
+	sourceSequence int64
+	attempts       uint32
+	lastStatus     string
+	effectiveFee   int64
+	feeBumped      bool
+	firstSeen      time.Time
+	nextRetry      time.Time
+	maxTime        xdr.TimePoint
+	submittedAt    uint32 // ledger sequence at first submission
+}
+
+// NewMempool constructs a Mempool reading its resubmission and fee-bump
+// settings from cfg, registering its metrics on registry. networkPassphrase
+// is used to sign fee-bump wrappers built during escalation.
+func NewMempool(cfg *config.Config, submitter *CoreClientWithMetrics, networkPassphrase string, registry *prometheus.Registry) *Mempool {
+	m := &Mempool{
+		entries:             make(map[string]*mempoolEntryInternal),
+		submitter:           submitter,
+		networkPassphrase:   networkPassphrase,
+		maxRetryBackoff:     30 * time.Second,
+		feeBumpAfterLedgers: cfg.MempoolFeeBumpAfterLedgers,
+		feeBumpMultiplier:   cfg.MempoolFeeBumpMultiplier,
+		feeBumpMaxFee:       cfg.MempoolFeeBumpMaxFee,
+		feeBumpSigningKey:   cfg.MempoolFeeBumpSigningKey,
+		depthGauge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: prometheusNamespace, Subsystem: "mempool", Name: "depth",
+			Help: "number of transactions currently tracked by the local mempool",
+		}),
+		ageHistogram: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: prometheusNamespace, Subsystem: "mempool", Name: "entry_age_seconds",
+			Help:    "age distribution of mempool entries at the time they are removed",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		resubmitCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: prometheusNamespace, Subsystem: "mempool", Name: "resubmissions_total",
+			Help: "number of automatic resubmissions performed by the local mempool",
+		}),
+		feeBumpCounter: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: prometheusNamespace, Subsystem: "mempool", Name: "fee_bumps_total",
+			Help: "number of fee-bump escalations performed by the local mempool",
+		}),
+	}
+	registry.MustRegister(m.depthGauge, m.ageHistogram, m.resubmitCounter, m.feeBumpCounter)
+	return m
+}
+
+// Track records a newly submitted envelope. If an identical envelope (by
+// hash) is already tracked, it instead increments that entry's attempt count,
+// records resp.Status as its last status, and returns it.
+func (m *Mempool) Track(hash string, envelopeBase64 string, envelope xdr.TransactionEnvelope, resp *proto.TXResponse, latestLedger uint32) *MempoolEntry {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if existing, ok := m.entries[hash]; ok {
+		existing.attempts++
+		existing.lastStatus = resp.Status
+		m.depthGauge.Set(float64(len(m.entries)))
+		return toMempoolEntry(existing)
+	}
+
+	sourceAccount := envelope.SourceAccount().ToAccountId().Address()
+	entry := &mempoolEntryInternal{
+		envelope:       envelope,
+		envelopeBase64: envelopeBase64,
+		hash:           hash,
+		sourceAccount:  sourceAccount,
+		sourceSequence: int64(envelope.SeqNum()),
+		attempts:       1,
+		lastStatus:     resp.Status,
+		effectiveFee:   int64(envelope.Fee()),
+		firstSeen:      time.Now(),
+		nextRetry:      time.Now().Add(time.Second),
+		submittedAt:    latestLedger,
+	}
+	m.entries[hash] = entry
+	m.depthGauge.Set(float64(len(m.entries)))
+	return toMempoolEntry(entry)
+}
+
+// Get returns the tracked entry for hash, if any.
+func (m *Mempool) Get(hash string) (*MempoolEntry, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entry, ok := m.entries[hash]
+	if !ok {
+		return nil, false
+	}
+	return toMempoolEntry(entry), true
+}
+
+// Resolve removes hash from the mempool once it has been observed as
+// applied or has definitively expired, recording its final age.
+func (m *Mempool) Resolve(hash string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entry, ok := m.entries[hash]
+	if !ok {
+		return
+	}
+	m.ageHistogram.Observe(time.Since(entry.firstSeen).Seconds())
+	delete(m.entries, hash)
+	m.depthGauge.Set(float64(len(m.entries)))
+}
+
+// RunResubmissionLoop periodically resubmits TXStatusTryAgainLater entries
+// with exponential backoff and escalates stale entries to a fee-bump, until
+// ctx is cancelled. It is intended to be run as a single background
+// goroutine for the lifetime of the daemon.
+func (m *Mempool) RunResubmissionLoop(ctx context.Context, logger *log.Entry, latestLedger func() uint32) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.tick(ctx, logger, latestLedger())
+		}
+	}
+}
+
+func (m *Mempool) tick(ctx context.Context, logger *log.Entry, latestLedger uint32) {
+	now := time.Now()
+	var due []*mempoolEntryInternal
+	m.lock.Lock()
+	for _, entry := range m.entries {
+		if entry.lastStatus == proto.TXStatusTryAgainLater && now.After(entry.nextRetry) {
+			due = append(due, entry)
+		}
+	}
+	m.lock.Unlock()
+
+	for _, entry := range due {
+		m.resubmit(ctx, logger, entry, latestLedger)
+	}
+}
+
+func (m *Mempool) resubmit(ctx context.Context, logger *log.Entry, entry *mempoolEntryInternal, latestLedger uint32) {
+	m.lock.Lock()
+	submittedAt := entry.submittedAt
+	feeBumped := entry.feeBumped
+	effectiveFee := entry.effectiveFee
+	envelopeBase64 := entry.envelopeBase64
+	m.lock.Unlock()
+
+	if latestLedger-submittedAt >= m.feeBumpAfterLedgers && !feeBumped {
+		newEnvelopeBase64, newEnvelope, newFee, err := m.buildFeeBump(effectiveFee, envelopeBase64)
+		if err != nil {
+			logger.WithError(err).WithField("hash", entry.hash).Warn("could not build fee-bump for stale transaction")
+		} else {
+			m.lock.Lock()
+			entry.envelopeBase64 = newEnvelopeBase64
+			entry.envelope = newEnvelope
+			entry.effectiveFee = newFee
+			entry.feeBumped = true
+			m.lock.Unlock()
+			envelopeBase64 = newEnvelopeBase64
+			m.feeBumpCounter.Inc()
+		}
+	}
+
+	resp, err := m.submitter.SubmitTransaction(ctx, envelopeBase64)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	entry.attempts++
+	// Double the delay with every attempt (2s, 4s, 8s, ...), up to maxRetryBackoff.
+	backoff := m.maxRetryBackoff
+	if entry.attempts <= 32 {
+		if b := time.Second << (entry.attempts - 1); b < backoff {
+			backoff = b
+		}
+	}
+	entry.nextRetry = time.Now().Add(backoff)
+	if err == nil {
+		entry.lastStatus = resp.Status
+	}
+	m.resubmitCounter.Inc()
+}
+
+// buildFeeBump wraps the envelope at envelopeBase64 (whose outer fee is
+// effectiveFee) in a FeeBumpTransaction paying m.feeBumpMultiplier times
+// effectiveFee, capped at m.feeBumpMaxFee, and signed with
+// m.feeBumpSigningKey. It returns the new envelope without touching any
+// mempoolEntryInternal, so the caller can apply the result under m.lock.
+func (m *Mempool) buildFeeBump(effectiveFee int64, envelopeBase64 string) (string, xdr.TransactionEnvelope, int64, error) {
+	if m.feeBumpSigningKey == "" {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("no fee-bump signing key configured")
+	}
+
+	feeBumpFee := effectiveFee * int64(m.feeBumpMultiplier)
+	if m.feeBumpMaxFee > 0 && feeBumpFee > m.feeBumpMaxFee {
+		feeBumpFee = m.feeBumpMaxFee
+	}
+	if feeBumpFee <= effectiveFee {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("fee-bump fee %d does not exceed current fee %d", feeBumpFee, effectiveFee)
+	}
+
+	inner, err := txnbuild.TransactionFromXDR(envelopeBase64)
+	if err != nil {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("parsing envelope for fee-bump: %w", err)
+	}
+	innerTx, ok := inner.Transaction()
+	if !ok {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("fee-bump transactions cannot themselves be fee-bumped")
+	}
+
+	signer, err := keypair.ParseFull(m.feeBumpSigningKey)
+	if err != nil {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("parsing fee-bump signing key: %w", err)
+	}
+
+	// BaseFee is charged per operation, and a fee-bump counts as one operation
+	// more than its inner transaction, so split the total across them.
+	feeBump, err := txnbuild.NewFeeBumpTransaction(txnbuild.FeeBumpTransactionParams{
+		Inner:      innerTx,
+		FeeAccount: signer.Address(),
+		BaseFee:    feeBumpFee / int64(len(innerTx.Operations())+1),
+	})
+	if err != nil {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("building fee-bump transaction: %w", err)
+	}
+	feeBump, err = feeBump.Sign(m.networkPassphrase, signer)
+	if err != nil {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("signing fee-bump transaction: %w", err)
+	}
+
+	newEnvelopeBase64, err := feeBump.Base64()
+	if err != nil {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("encoding fee-bump transaction: %w", err)
+	}
+	var newEnvelope xdr.TransactionEnvelope
+	if err := xdr.SafeUnmarshalBase64(newEnvelopeBase64, &newEnvelope); err != nil {
+		return "", xdr.TransactionEnvelope{}, 0, fmt.Errorf("decoding fee-bump envelope: %w", err)
+	}
+
+	return newEnvelopeBase64, newEnvelope, feeBump.MaxFee(), nil
+}
+
+func toMempoolEntry(e *mempoolEntryInternal) *MempoolEntry {
+	return &MempoolEntry{
+		Hash:           e.hash,
+		SourceAccount:  e.sourceAccount,
+		SourceSequence: e.sourceSequence,
+		Attempts:       e.attempts,
+		LastStatus:     e.lastStatus,
+		EffectiveFee:   e.effectiveFee,
+		FeeBumped:      e.feeBumped,
+		FirstSeen:      e.firstSeen,
+		NextRetry:      e.nextRetry,
+	}
+}
@@ -0,0 +1,79 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+	"github.com/HashCash-Consultants/go/xdr"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/config"
+)
+
+func newTestMempool(t *testing.T) *Mempool {
+	t.Helper()
+	cfg := &config.Config{
+		MempoolFeeBumpAfterLedgers: 5,
+		MempoolFeeBumpMultiplier:   2,
+		MempoolFeeBumpMaxFee:       1000,
+	}
+	return NewMempool(cfg, nil, "Test SDF Network ; September 2015", prometheus.NewRegistry())
+}
+
+// testEnvelope returns a minimal V1 envelope whose source account, sequence
+// number and fee Track can read.
+func testEnvelope() xdr.TransactionEnvelope {
+	var sourceKey xdr.Uint256
+	return xdr.TransactionEnvelope{
+		Type: xdr.EnvelopeTypeEnvelopeTypeTx,
+		V1: &xdr.TransactionV1Envelope{
+			Tx: xdr.Transaction{
+				SourceAccount: xdr.MuxedAccount{Type: xdr.CryptoKeyTypeKeyTypeEd25519, Ed25519: &sourceKey},
+				Fee:           100,
+				SeqNum:        1,
+			},
+		},
+	}
+}
+
+func TestMempoolTrackDedupesByHash(t *testing.T) {
+	m := newTestMempool(t)
+	resp := &proto.TXResponse{Status: proto.TXStatusPending}
+
+	first := m.Track("deadbeef", "envelope-base64", testEnvelope(), resp, 100)
+	if first.Attempts != 1 {
+		t.Fatalf("expected first Track to start at 1 attempt, got %d", first.Attempts)
+	}
+
+	second := m.Track("deadbeef", "envelope-base64", testEnvelope(), resp, 101)
+	if second.Attempts != 2 {
+		t.Fatalf("expected repeated Track to increment attempts, got %d", second.Attempts)
+	}
+
+	if got, ok := m.Get("deadbeef"); !ok || got.Attempts != 2 {
+		t.Fatalf("expected Get to return the deduplicated entry, got %+v (ok=%v)", got, ok)
+	}
+}
+
+func TestMempoolResolveRemovesEntry(t *testing.T) {
+	m := newTestMempool(t)
+	resp := &proto.TXResponse{Status: proto.TXStatusPending}
+	m.Track("deadbeef", "envelope-base64", testEnvelope(), resp, 100)
+
+	m.Resolve("deadbeef")
+
+	if _, ok := m.Get("deadbeef"); ok {
+		t.Fatalf("expected Resolve to remove the tracked entry")
+	}
+
+	// Resolving an unknown hash is a no-op, not an error.
+	m.Resolve("not-tracked")
+}
+
+func TestMempoolBuildFeeBumpRequiresSigningKey(t *testing.T) {
+	m := newTestMempool(t)
+
+	if _, _, _, err := m.buildFeeBump(100, "envelope-base64"); err == nil {
+		t.Fatalf("expected buildFeeBump to fail without a configured signing key")
+	}
+}
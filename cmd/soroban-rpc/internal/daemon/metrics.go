@@ -7,8 +7,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/HashCash-Consultants/go/clients/hcnetcore"
-	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+	"go.opentelemetry.io/otel/attribute"
 	"github.com/HashCash-Consultants/go/support/logmetrics"
 	"github.com/HashCash-Consultants/go/xdr"
 
@@ -49,57 +48,88 @@ func (d *Daemon) MetricsNamespace() string {
 	return prometheusNamespace
 }
 
-type CoreClientWithMetrics struct {
-	hcnetcore.Client
+// submissionMetrics holds the Prometheus vectors shared by every
+// CoreClientWithMetrics wrapping an individual backend, so that fanning out
+// to several cores plus a Horizon fallback registers each metric exactly
+// once regardless of how many backends are wrapped.
+type submissionMetrics struct {
 	submitMetric  *prometheus.SummaryVec
 	opCountMetric *prometheus.SummaryVec
 }
 
-func newCoreClientWithMetrics(client hcnetcore.Client, registry *prometheus.Registry) *CoreClientWithMetrics {
+func newSubmissionMetrics(registry *prometheus.Registry) *submissionMetrics {
 	submitMetric := prometheus.NewSummaryVec(prometheus.SummaryOpts{
 		Namespace: prometheusNamespace, Subsystem: "txsub", Name: "submission_duration_seconds",
 		Help:       "submission durations to Hcnet-Core, sliding window = 10m",
 		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
-	}, []string{"status"})
+	}, []string{"status", "backend"})
 	opCountMetric := prometheus.NewSummaryVec(prometheus.SummaryOpts{
 		Namespace: prometheusNamespace, Subsystem: "txsub", Name: "operation_count",
 		Help:       "number of operations included in a transaction, sliding window = 10m",
 		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
-	}, []string{"status"})
+	}, []string{"status", "backend"})
 	registry.MustRegister(submitMetric, opCountMetric)
+	return &submissionMetrics{submitMetric: submitMetric, opCountMetric: opCountMetric}
+}
 
+// CoreClientWithMetrics wraps a single TransactionSubmitter backend - one
+// hcnet-core instance, or the Horizon fallback adapter - with Prometheus
+// instrumentation labelled by submission status and backendName. Wrap each
+// backend individually (see NewCompositeSubmitter) rather than the fanned-out
+// composite, so every backend attempted shows up in the metrics, not just
+// the one whose result won the race.
+type CoreClientWithMetrics struct {
+	interfaces.TransactionSubmitter
+	backendName string
+	metrics     *submissionMetrics
+}
+
+func newCoreClientWithMetrics(backendName string, submitter interfaces.TransactionSubmitter, metrics *submissionMetrics) *CoreClientWithMetrics {
 	return &CoreClientWithMetrics{
-		Client:        client,
-		submitMetric:  submitMetric,
-		opCountMetric: opCountMetric,
+		TransactionSubmitter: submitter,
+		backendName:          backendName,
+		metrics:              metrics,
 	}
 }
 
-func (c *CoreClientWithMetrics) SubmitTransaction(ctx context.Context, envelopeBase64 string) (*proto.TXResponse, error) {
+func (c *CoreClientWithMetrics) SubmitTransaction(ctx context.Context, envelopeBase64 string) (interfaces.SubmitResult, error) {
+	ctx, span := StartSpan(ctx, "CoreClientWithMetrics.SubmitTransaction")
+	defer span.End()
+
 	var envelope xdr.TransactionEnvelope
 	err := xdr.SafeUnmarshalBase64(envelopeBase64, &envelope)
 	if err != nil {
-		return nil, err
+		return interfaces.SubmitResult{}, err
 	}
+	span.SetAttributes(
+		attribute.String("backend", c.backendName),
+		attribute.Int("envelope_size_bytes", len(envelopeBase64)),
+		attribute.Int("operation_count", len(envelope.Operations())),
+	)
 
 	startTime := time.Now()
-	response, err := c.Client.SubmitTransaction(ctx, envelopeBase64)
+	result, err := c.TransactionSubmitter.SubmitTransaction(ctx, envelopeBase64)
 	duration := time.Since(startTime).Seconds()
+	result.Backend = c.backendName
 
 	var label prometheus.Labels
 	if err != nil {
-		label = prometheus.Labels{"status": "request_error"}
-	} else if response.IsException() {
-		label = prometheus.Labels{"status": "exception"}
+		label = prometheus.Labels{"status": "request_error", "backend": c.backendName}
+	} else if result.IsException() {
+		label = prometheus.Labels{"status": "exception", "backend": c.backendName}
 	} else {
-		label = prometheus.Labels{"status": response.Status}
+		label = prometheus.Labels{"status": result.Status, "backend": c.backendName}
 	}
+	span.SetAttributes(attribute.String("core_status", label["status"]))
 
-	c.submitMetric.With(label).Observe(duration)
-	c.opCountMetric.With(label).Observe(float64(len(envelope.Operations())))
-	return response, err
+	c.metrics.submitMetric.With(label).Observe(duration)
+	c.metrics.opCountMetric.With(label).Observe(float64(len(envelope.Operations())))
+	return result, err
 }
 
+// CoreClient returns the composite TransactionSubmitter configured for this
+// daemon - potentially fanning out to multiple cores and/or falling back
+// to Horizon - instrumented with submission metrics.
 func (d *Daemon) CoreClient() interfaces.CoreClient {
 	return d.coreClient
 }
@@ -0,0 +1,31 @@
+package daemon
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	horizonclient "github.com/HashCash-Consultants/go/clients/horizonclient"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon/interfaces"
+)
+
+// NewCompositeSubmitter builds the TransactionSubmitter a Daemon hands out
+// via CoreClient(): cores, labelled by names, are submitted to in parallel
+// via a multiCoreSubmitter, with the result falling back to horizon (when
+// non-nil) on a request error. Every backend - each core and horizon - is
+// wrapped in its own CoreClientWithMetrics so submission_duration_seconds
+// and operation_count carry a data point for each backend attempted, not
+// just whichever one's result won the race.
+func NewCompositeSubmitter(cores []interfaces.TransactionSubmitter, names []string, horizon *horizonclient.Client, registry *prometheus.Registry) interfaces.TransactionSubmitter {
+	metrics := newSubmissionMetrics(registry)
+
+	meteredCores := make([]interfaces.TransactionSubmitter, len(cores))
+	for i, core := range cores {
+		meteredCores[i] = newCoreClientWithMetrics(names[i], core, metrics)
+	}
+
+	var submitter interfaces.TransactionSubmitter = NewMultiCoreSubmitter(meteredCores, names)
+	if horizon != nil {
+		meteredHorizon := newCoreClientWithMetrics("horizon", NewHorizonSubmitter(horizon), metrics)
+		submitter = NewHorizonFallbackSubmitter(submitter, meteredHorizon)
+	}
+	return submitter
+}
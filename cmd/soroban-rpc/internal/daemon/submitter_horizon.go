@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	horizonclient "github.com/HashCash-Consultants/go/clients/horizonclient"
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon/interfaces"
+)
+
+// horizonFallbackSubmitter submits through a primary TransactionSubmitter -
+// typically a single core or a multiCoreSubmitter - and retries through the
+// fallback TransactionSubmitter when the primary could not reach any core at
+// all, rather than every request error.
+type horizonFallbackSubmitter struct {
+	primary  interfaces.TransactionSubmitter
+	fallback interfaces.TransactionSubmitter
+}
+
+// NewHorizonFallbackSubmitter wraps primary so that submissions which fail
+// with a request error (no core reachable) are retried against fallback.
+// fallback is typically horizonSubmitter wrapped in its own
+// CoreClientWithMetrics, so the Horizon leg is metered like any other
+// backend.
+func NewHorizonFallbackSubmitter(primary interfaces.TransactionSubmitter, fallback interfaces.TransactionSubmitter) interfaces.TransactionSubmitter {
+	return &horizonFallbackSubmitter{primary: primary, fallback: fallback}
+}
+
+func (h *horizonFallbackSubmitter) SubmitTransaction(ctx context.Context, envelopeXdr string) (interfaces.SubmitResult, error) {
+	result, err := h.primary.SubmitTransaction(ctx, envelopeXdr)
+	if err == nil {
+		return result, nil
+	}
+
+	fallbackResult, fallbackErr := h.fallback.SubmitTransaction(ctx, envelopeXdr)
+	if fallbackErr != nil {
+		// Neither backend could accept the transaction; surface the
+		// original core error since it carries the more actionable status.
+		return result, err
+	}
+	return fallbackResult, nil
+}
+
+func (h *horizonFallbackSubmitter) Info(ctx context.Context) (*proto.InfoResponse, error) {
+	return h.primary.Info(ctx)
+}
+
+// horizonSubmitter adapts a horizonclient.Client to TransactionSubmitter so
+// it can be wrapped in a CoreClientWithMetrics and metered the same way as
+// any hcnet-core backend.
+type horizonSubmitter struct {
+	client *horizonclient.Client
+}
+
+// NewHorizonSubmitter wraps client as a TransactionSubmitter.
+func NewHorizonSubmitter(client *horizonclient.Client) interfaces.TransactionSubmitter {
+	return &horizonSubmitter{client: client}
+}
+
+func (h *horizonSubmitter) SubmitTransaction(_ context.Context, envelopeXdr string) (interfaces.SubmitResult, error) {
+	resp, err := h.client.SubmitTransactionXDR(envelopeXdr)
+	if err != nil {
+		return interfaces.SubmitResult{}, err
+	}
+	return interfaces.SubmitResult{
+		TXResponse: &proto.TXResponse{
+			Status: proto.TXStatusPending,
+			Hash:   resp.Hash,
+		},
+	}, nil
+}
+
+func (h *horizonSubmitter) Info(_ context.Context) (*proto.InfoResponse, error) {
+	return nil, fmt.Errorf("horizon backend does not support the Info endpoint")
+}
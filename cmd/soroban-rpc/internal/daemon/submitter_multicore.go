@@ -0,0 +1,74 @@
+package daemon
+
+import (
+	"context"
+
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon/interfaces"
+)
+
+// multiCoreSubmitter fans a transaction submission out to several
+// hcnet-core instances in parallel and returns the first response that
+// isn't a bare request error, preferring any non-TryAgainLater status over
+// one, so a single stalled or crashed core does not fail the submission.
+type multiCoreSubmitter struct {
+	cores []interfaces.TransactionSubmitter
+	names []string
+}
+
+// NewMultiCoreSubmitter wraps cores - labelled by names for metrics and
+// logging - as a single TransactionSubmitter that submits to all of them
+// concurrently.
+func NewMultiCoreSubmitter(cores []interfaces.TransactionSubmitter, names []string) interfaces.TransactionSubmitter {
+	return &multiCoreSubmitter{cores: cores, names: names}
+}
+
+type coreSubmitResult struct {
+	result interfaces.SubmitResult
+	err    error
+}
+
+func (m *multiCoreSubmitter) SubmitTransaction(ctx context.Context, envelopeXdr string) (interfaces.SubmitResult, error) {
+	results := make(chan coreSubmitResult, len(m.cores))
+	for i, core := range m.cores {
+		i, core := i, core
+		go func() {
+			result, err := core.SubmitTransaction(ctx, envelopeXdr)
+			result.Backend = m.names[i]
+			results <- coreSubmitResult{result: result, err: err}
+		}()
+	}
+
+	// Pick the best response out of every core's result: a successful
+	// response beats a request error, and among successful responses, any
+	// status other than TryAgainLater beats one, so a single stalled or
+	// crashed core does not fail the whole submission. As soon as a
+	// definitive result (no error, not TryAgainLater) is in hand, return it
+	// immediately rather than waiting on every core - results is buffered to
+	// len(m.cores), so any core still in flight (including one that never
+	// responds at all) can send without blocking or leaking its goroutine.
+	var best coreSubmitResult
+	haveBest := false
+	for i := 0; i < len(m.cores); i++ {
+		r := <-results
+		if !haveBest {
+			best = r
+			haveBest = true
+		} else if best.err != nil && r.err == nil {
+			best = r
+		} else if best.err == nil && r.err == nil &&
+			best.result.Status == proto.TXStatusTryAgainLater &&
+			r.result.Status != proto.TXStatusTryAgainLater {
+			best = r
+		}
+		if best.err == nil && best.result.Status != proto.TXStatusTryAgainLater {
+			return best.result, best.err
+		}
+	}
+	return best.result, best.err
+}
+
+func (m *multiCoreSubmitter) Info(ctx context.Context) (*proto.InfoResponse, error) {
+	return m.cores[0].Info(ctx)
+}
@@ -0,0 +1,76 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon/interfaces"
+)
+
+type stubSubmitter struct {
+	result interfaces.SubmitResult
+	err    error
+}
+
+func (s stubSubmitter) SubmitTransaction(context.Context, string) (interfaces.SubmitResult, error) {
+	return s.result, s.err
+}
+
+func (s stubSubmitter) Info(context.Context) (*proto.InfoResponse, error) {
+	return nil, nil
+}
+
+func TestMultiCoreSubmitterPrefersNonErrorResult(t *testing.T) {
+	m := &multiCoreSubmitter{
+		cores: []interfaces.TransactionSubmitter{
+			stubSubmitter{err: errors.New("unreachable")},
+			stubSubmitter{result: interfaces.SubmitResult{TXResponse: &proto.TXResponse{Status: proto.TXStatusPending}}},
+		},
+		names: []string{"core:0", "core:1"},
+	}
+
+	result, err := m.SubmitTransaction(context.Background(), "envelope")
+	if err != nil {
+		t.Fatalf("expected a successful result, got error %v", err)
+	}
+	if result.Backend != "core:1" || result.Status != proto.TXStatusPending {
+		t.Fatalf("expected core:1's pending result to win, got %+v", result)
+	}
+}
+
+func TestMultiCoreSubmitterPrefersNonTryAgainLater(t *testing.T) {
+	m := &multiCoreSubmitter{
+		cores: []interfaces.TransactionSubmitter{
+			stubSubmitter{result: interfaces.SubmitResult{TXResponse: &proto.TXResponse{Status: proto.TXStatusTryAgainLater}}},
+			stubSubmitter{result: interfaces.SubmitResult{TXResponse: &proto.TXResponse{Status: proto.TXStatusPending}}},
+		},
+		names: []string{"core:0", "core:1"},
+	}
+
+	result, err := m.SubmitTransaction(context.Background(), "envelope")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != proto.TXStatusPending {
+		t.Fatalf("expected the non-TryAgainLater result to win, got status %q", result.Status)
+	}
+}
+
+func TestMultiCoreSubmitterReturnsErrorWhenAllCoresFail(t *testing.T) {
+	wantErr := errors.New("unreachable")
+	m := &multiCoreSubmitter{
+		cores: []interfaces.TransactionSubmitter{
+			stubSubmitter{err: wantErr},
+			stubSubmitter{err: wantErr},
+		},
+		names: []string{"core:0", "core:1"},
+	}
+
+	_, err := m.SubmitTransaction(context.Background(), "envelope")
+	if err == nil {
+		t.Fatalf("expected an error when every core fails")
+	}
+}
@@ -0,0 +1,111 @@
+package daemon
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/config"
+)
+
+// tracerName identifies this package's spans in exported traces.
+const tracerName = "github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc"
+
+// tracerBox lets tracerHolder store a trace.Tracer in an atomic.Value,
+// which requires every stored value to share a concrete type.
+type tracerBox struct {
+	tracer trace.Tracer
+}
+
+// tracerHolder is read by StartSpan from request-handling goroutines and
+// replaced by registerTracing once a real exporter is configured; the
+// atomic.Value gives both sides a data-race-free view of the current
+// tracer. It defaults to a no-op tracer until registerTracing runs.
+var tracerHolder atomic.Value
+
+func init() {
+	tracerHolder.Store(tracerBox{tracer: otel.Tracer(tracerName)})
+}
+
+// propagator propagates W3C traceparent/tracestate headers between incoming
+// HTTP requests and outgoing spans so that client-side traces stitch
+// together end-to-end with Soroban-RPC's own spans.
+var propagator = propagation.TraceContext{}
+
+// registerTracing configures an OTLP exporter for this daemon, parallel to
+// registerMetrics, and adds a tracing_enabled gauge to the Prometheus
+// registry so operators can confirm the exporter is live. It is a no-op,
+// beyond publishing the gauge as 0, when cfg.TracingEnabled is false.
+func (d *Daemon) registerTracing(cfg *config.Config) error {
+	enabledGauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: prometheusNamespace, Subsystem: "tracing", Name: "enabled",
+		Help: "1 if OpenTelemetry tracing is configured with a live OTLP exporter, 0 otherwise",
+	})
+	d.metricsRegistry.MustRegister(enabledGauge)
+
+	if !cfg.TracingEnabled {
+		return nil
+	}
+
+	exporter, err := otlptracegrpc.New(context.Background(),
+		otlptracegrpc.WithEndpoint(cfg.TracingOTLPEndpoint),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName("soroban-rpc"),
+		semconv.ServiceVersion(config.Version),
+	))
+	if err != nil {
+		return err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.TraceIDRatioBased(cfg.TracingSamplerRatio)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	tracerHolder.Store(tracerBox{tracer: provider.Tracer(tracerName)})
+
+	enabledGauge.Set(1)
+	return nil
+}
+
+// ExtractTraceContext propagates a W3C traceparent header from an incoming
+// JSON-RPC HTTP request into ctx, so spans started from ctx join the
+// caller's trace. See TracingMiddleware for the HTTP call site.
+func ExtractTraceContext(ctx context.Context, header http.Header) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// TracingMiddleware wraps next so that every incoming HTTP request's W3C
+// traceparent/tracestate headers, if present, are extracted into the
+// request context before next is invoked - the call site that makes
+// ExtractTraceContext take effect for the JSON-RPC HTTP/WebSocket handler.
+func TracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := ExtractTraceContext(r.Context(), r.Header)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// StartSpan starts a span named name as a child of the span (if any) carried
+// by ctx.
+func StartSpan(ctx context.Context, name string, attrs ...trace.SpanStartOption) (context.Context, trace.Span) {
+	box := tracerHolder.Load().(tracerBox)
+	return box.tracer.Start(ctx, name, attrs...)
+}
@@ -0,0 +1,70 @@
+package methods
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/creachadair/jrpc2"
+	"github.com/creachadair/jrpc2/handler"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon"
+)
+
+// GetMempoolEntryRequest is the request for getMempoolEntry.
+type GetMempoolEntryRequest struct {
+	// Hash is the hex-encoded hash of the transaction to look up.
+	Hash string `json:"hash"`
+}
+
+// GetMempoolEntryResponse describes the local mempool's current view of a
+// submitted transaction that has not yet been observed as applied or
+// definitively expired.
+type GetMempoolEntryResponse struct {
+	// Hash is the transaction hash this entry concerns.
+	Hash string `json:"hash"`
+	// SourceAccount is the strkey-encoded source account of the transaction.
+	SourceAccount string `json:"sourceAccount"`
+	// SourceSequence is the sequence number of the transaction.
+	SourceSequence int64 `json:"sourceSequence,string"`
+	// Attempts is the number of times this transaction has been submitted
+	// to hcnet-core, including the original submission.
+	Attempts uint32 `json:"attempts"`
+	// LastStatus is the most recently observed submission status.
+	LastStatus string `json:"lastStatus"`
+	// EffectiveFee is the fee, in stroops, of the envelope that will be (or
+	// was most recently) submitted, which may be a fee-bump wrapper.
+	EffectiveFee int64 `json:"effectiveFee,string"`
+	// FeeBumped is true once the mempool has escalated this transaction to
+	// a fee-bump wrapper.
+	FeeBumped bool `json:"feeBumped"`
+	// NextRetryUnixTime is the unix timestamp at which the mempool will
+	// next attempt to resubmit this transaction, if applicable.
+	NextRetryUnixTime int64 `json:"nextRetryUnixTime,string"`
+}
+
+// NewGetMempoolEntryHandler returns a jrpc2 handler for the getMempoolEntry
+// method, which exposes the local mempool's tracked state for a submitted
+// transaction: its attempt count, last known status, effective fee, and
+// next scheduled retry time.
+func NewGetMempoolEntryHandler(mempool *daemon.Mempool) jrpc2.Handler {
+	return handler.New(func(_ context.Context, request GetMempoolEntryRequest) (GetMempoolEntryResponse, error) {
+		entry, ok := mempool.Get(request.Hash)
+		if !ok {
+			return GetMempoolEntryResponse{}, &jrpc2.Error{
+				Code:    jrpc2.InvalidParams,
+				Message: "transaction not found in mempool: " + strconv.Quote(request.Hash),
+			}
+		}
+
+		return GetMempoolEntryResponse{
+			Hash:              entry.Hash,
+			SourceAccount:     entry.SourceAccount,
+			SourceSequence:    entry.SourceSequence,
+			Attempts:          entry.Attempts,
+			LastStatus:        entry.LastStatus,
+			EffectiveFee:      entry.EffectiveFee,
+			FeeBumped:         entry.FeeBumped,
+			NextRetryUnixTime: entry.NextRetry.Unix(),
+		}, nil
+	})
+}
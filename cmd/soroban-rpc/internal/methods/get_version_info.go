@@ -2,14 +2,26 @@ package methods
 
 import (
 	"context"
+	"encoding/hex"
+	"runtime/debug"
+	"sync"
+
 	"github.com/creachadair/jrpc2"
 	"github.com/creachadair/jrpc2/handler"
+	"go.opentelemetry.io/otel/attribute"
+	"github.com/HashCash-Consultants/go/network"
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
 	"github.com/HashCash-Consultants/go/support/log"
+
 	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/config"
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon"
 	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon/interfaces"
 	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/db"
 )
 
+// GetVersionInfoResponse represents the build information historically
+// returned by getVersionInfo. It is embedded in GetNodeInfoResponse so that
+// getVersionInfo remains a thin alias reporting the same fields.
 type GetVersionInfoResponse struct {
 	Version            string `json:"version"`
 	CommitHash         string `json:"commit_hash"`
@@ -18,44 +30,212 @@ type GetVersionInfoResponse struct {
 	ProtocolVersion    uint32 `json:"protocol_version"`
 }
 
-func NewGetVersionInfoHandler(logger *log.Entry, ledgerEntryReader db.LedgerEntryReader, ledgerReader db.LedgerReader, daemon interfaces.Daemon) jrpc2.Handler {
-	coreClient := daemon.CoreClient()
-	return handler.New(func(ctx context.Context) (GetVersionInfoResponse, error) {
+// LedgerRangeInfo describes the span of ledgers Soroban-RPC has ingested.
+type LedgerRangeInfo struct {
+	OldestLedger          uint32 `json:"oldestLedger"`
+	OldestLedgerCloseTime int64  `json:"oldestLedgerCloseTime,string"`
+	NewestLedger          uint32 `json:"newestLedger"`
+	NewestLedgerCloseTime int64  `json:"newestLedgerCloseTime,string"`
+}
 
-		var captiveCoreVersion string
-		info, err := coreClient.Info(ctx)
-		if err != nil {
-			logger.WithError(err).Info("error occurred while calling Info endpoint of core")
-		} else {
-			captiveCoreVersion = info.Info.Build
-		}
+// DependencyInfo is the resolved version of a single Go module dependency
+// baked into the running binary.
+type DependencyInfo struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+}
 
-		// Fetch Protocol version
-		var protocolVersion uint32
-		readTx, err := ledgerEntryReader.NewCachedTx(ctx)
-		if err != nil {
-			logger.WithError(err).Info("Cannot create read transaction")
-		}
-		defer func() {
-			_ = readTx.Done()
-		}()
+// GetNodeInfoResponse is the richer node status and build manifest reported
+// by getNodeInfo, which SDKs and monitoring can use to determine
+// compatibility without scraping /metrics.
+type GetNodeInfoResponse struct {
+	GetVersionInfoResponse
+	// NetworkPassphrase is the network passphrase this node is configured
+	// to serve.
+	NetworkPassphrase string `json:"network_passphrase"`
+	// NetworkID is the hex-encoded network ID hash derived from
+	// NetworkPassphrase.
+	NetworkID string `json:"network_id"`
+	// LedgerRange is the range of ledgers currently ingested by this node.
+	LedgerRange LedgerRangeInfo `json:"ledger_range"`
+	// CoreSynced reports whether the underlying hcnet-core reports itself
+	// as synced with the network.
+	CoreSynced bool `json:"core_synced"`
+	// CoreQuorumHealthy reports whether hcnet-core's quorum set has no
+	// validators in a critical state.
+	CoreQuorumHealthy bool `json:"core_quorum_healthy"`
+	// DBSizeBytes is the on-disk size of the RPC's database.
+	DBSizeBytes int64 `json:"db_size_bytes"`
+	// RetentionWindow is the number of ledgers this node retains data for.
+	RetentionWindow uint32 `json:"retention_window"`
+	// EnabledMethods lists the JSON-RPC methods this node currently serves.
+	EnabledMethods []string `json:"enabled_methods"`
+	// Dependencies is a manifest of the versions of every Go module
+	// dependency embedded in the binary at build time.
+	Dependencies []DependencyInfo `json:"dependencies"`
+}
 
-		latestLedger, err := readTx.GetLatestLedgerSequence()
-		if err != nil {
-			logger.WithError(err).Info("error occurred while getting latest ledger")
-		}
+// DBSizer reports the on-disk size of the RPC's database. It is implemented
+// by the concrete db.DB type on storage backends that support it; pass a
+// nil DBSizer to NewGetNodeInfoHandler where it is not available.
+type DBSizer interface {
+	DatabaseSize(ctx context.Context) (int64, error)
+}
 
-		_, protocolVersion, err = getBucketListSizeAndProtocolVersion(ctx, ledgerReader, latestLedger)
-		if err != nil {
-			logger.WithError(err).Info("error occurred while fetching protocol version")
+var (
+	buildManifestOnce sync.Once
+	buildManifest     []DependencyInfo
+)
+
+// buildDependencyManifest reads and caches the module versions linked into
+// the running binary, analogous to the build_info Prometheus gauge but
+// exposed via RPC.
+func buildDependencyManifest() []DependencyInfo {
+	buildManifestOnce.Do(func() {
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			return
+		}
+		buildManifest = make([]DependencyInfo, 0, len(info.Deps))
+		for _, dep := range info.Deps {
+			buildManifest = append(buildManifest, DependencyInfo{Path: dep.Path, Version: dep.Version})
 		}
+	})
+	return buildManifest
+}
+
+// nodeSnapshot bundles everything getVersionInfo and getNodeInfo need from
+// hcnet-core and the database, fetched via exactly one Info call and one
+// read transaction, so getNodeInfo can reuse it instead of fetching twice.
+type nodeSnapshot struct {
+	versionInfo  GetVersionInfoResponse
+	latestLedger uint32
+	oldestLedger uint32
+	coreInfo     *proto.InfoResponse
+}
+
+func fetchNodeSnapshot(ctx context.Context, logger *log.Entry, ledgerEntryReader db.LedgerEntryReader, ledgerReader db.LedgerReader, d interfaces.Daemon) nodeSnapshot {
+	ctx, span := daemon.StartSpan(ctx, "fetchNodeSnapshot")
+	defer span.End()
+
+	coreClient := d.CoreClient()
+
+	var captiveCoreVersion string
+	coreInfo, err := coreClient.Info(ctx)
+	if err != nil {
+		logger.WithError(err).Info("error occurred while calling Info endpoint of core")
+	} else {
+		captiveCoreVersion = coreInfo.Info.Build
+	}
+
+	readTxCtx, readTxSpan := daemon.StartSpan(ctx, "ledgerEntryReader.NewCachedTx")
+	readTx, err := ledgerEntryReader.NewCachedTx(readTxCtx)
+	if err != nil {
+		logger.WithError(err).Info("Cannot create read transaction")
+	}
+	defer func() {
+		_ = readTx.Done()
+		readTxSpan.End()
+	}()
 
-		return GetVersionInfoResponse{
+	latestLedger, err := readTx.GetLatestLedgerSequence()
+	if err != nil {
+		logger.WithError(err).Info("error occurred while getting latest ledger")
+	}
+	readTxSpan.SetAttributes(attribute.Int64("latest_ledger", int64(latestLedger)))
+
+	oldestLedger, err := readTx.GetOldestLedgerSequence()
+	if err != nil {
+		logger.WithError(err).Info("error occurred while getting oldest ledger")
+	}
+
+	_, protocolVersion, err := getBucketListSizeAndProtocolVersion(ctx, ledgerReader, latestLedger)
+	if err != nil {
+		logger.WithError(err).Info("error occurred while fetching protocol version")
+	}
+
+	return nodeSnapshot{
+		versionInfo: GetVersionInfoResponse{
 			Version:            config.Version,
 			CommitHash:         config.CommitHash,
 			BuildTimestamp:     config.BuildTimestamp,
 			CaptiveCoreVersion: captiveCoreVersion,
 			ProtocolVersion:    protocolVersion,
+		},
+		latestLedger: latestLedger,
+		oldestLedger: oldestLedger,
+		coreInfo:     coreInfo,
+	}
+}
+
+// NewGetVersionInfoHandler returns a getVersionInfo json rpc handler, kept
+// as a thin alias over the version-related subset of getNodeInfo.
+func NewGetVersionInfoHandler(logger *log.Entry, ledgerEntryReader db.LedgerEntryReader, ledgerReader db.LedgerReader, d interfaces.Daemon) jrpc2.Handler {
+	return handler.New(func(ctx context.Context) (GetVersionInfoResponse, error) {
+		ctx, span := daemon.StartSpan(ctx, "NewGetVersionInfoHandler")
+		defer span.End()
+		snapshot := fetchNodeSnapshot(ctx, logger, ledgerEntryReader, ledgerReader, d)
+		return snapshot.versionInfo, nil
+	})
+}
+
+// NewGetNodeInfoHandler returns a getNodeInfo json rpc handler which reports
+// build information, the currently ingested ledger range, core sync and
+// quorum health, database size and retention, the set of enabled JSON-RPC
+// methods, and a manifest of embedded Go module dependency versions.
+// dbSizer may be nil if the configured storage backend does not support
+// reporting its size.
+func NewGetNodeInfoHandler(logger *log.Entry, ledgerEntryReader db.LedgerEntryReader, ledgerReader db.LedgerReader, d interfaces.Daemon, dbSizer DBSizer, passphrase string, retentionWindow uint32, enabledMethods []string) jrpc2.Handler {
+	return handler.New(func(ctx context.Context) (GetNodeInfoResponse, error) {
+		ctx, span := daemon.StartSpan(ctx, "NewGetNodeInfoHandler")
+		defer span.End()
+
+		snapshot := fetchNodeSnapshot(ctx, logger, ledgerEntryReader, ledgerReader, d)
+
+		networkID := network.ID(passphrase)
+
+		ledgerRange := LedgerRangeInfo{NewestLedger: snapshot.latestLedger, OldestLedger: snapshot.oldestLedger}
+		if newestHeader, err := ledgerReader.GetLedger(ctx, snapshot.latestLedger); err == nil {
+			ledgerRange.NewestLedgerCloseTime = int64(newestHeader.Header.ScpValue.CloseTime)
+		}
+		if snapshot.oldestLedger != 0 {
+			if oldestHeader, err := ledgerReader.GetLedger(ctx, snapshot.oldestLedger); err == nil {
+				ledgerRange.OldestLedgerCloseTime = int64(oldestHeader.Header.ScpValue.CloseTime)
+			}
+		}
+
+		var coreSynced, quorumHealthy bool
+		if snapshot.coreInfo != nil {
+			coreSynced = snapshot.coreInfo.Info.State == "Synced!"
+			quorumHealthy = true
+			for _, q := range snapshot.coreInfo.Info.Quorum {
+				if q.Critical != nil && *q.Critical {
+					quorumHealthy = false
+					break
+				}
+			}
+		}
+
+		var dbSizeBytes int64
+		if dbSizer != nil {
+			if size, err := dbSizer.DatabaseSize(ctx); err != nil {
+				logger.WithError(err).Info("error occurred while fetching database size")
+			} else {
+				dbSizeBytes = size
+			}
+		}
+
+		return GetNodeInfoResponse{
+			GetVersionInfoResponse: snapshot.versionInfo,
+			NetworkPassphrase:      passphrase,
+			NetworkID:              hex.EncodeToString(networkID[:]),
+			LedgerRange:            ledgerRange,
+			CoreSynced:             coreSynced,
+			CoreQuorumHealthy:      quorumHealthy,
+			DBSizeBytes:            dbSizeBytes,
+			RetentionWindow:        retentionWindow,
+			EnabledMethods:         enabledMethods,
+			Dependencies:           buildDependencyManifest(),
 		}, nil
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,81 @@
+package methods
+
+import (
+	"context"
+	"strings"
+
+	"github.com/HashCash-Consultants/go/xdr"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/preflight"
+)
+
+// PreflightMode controls whether NewSendTransactionHandler simulates
+// Soroban transactions before forwarding them to hcnet-core, configured via
+// the SEND_TRANSACTION_PREFLIGHT_MODE setting.
+type PreflightMode string
+
+const (
+	// PreflightModeOff skips preflight simulation entirely.
+	PreflightModeOff PreflightMode = "off"
+	// PreflightModeWarn runs the simulation and logs definite failures but
+	// still forwards the transaction to hcnet-core.
+	PreflightModeWarn PreflightMode = "warn"
+	// PreflightModeReject runs the simulation and returns a synthetic ERROR
+	// response for definite failures without contacting hcnet-core.
+	PreflightModeReject PreflightMode = "reject"
+)
+
+// hasSorobanOperation reports whether envelope contains an operation whose
+// outcome preflight simulation can predict ahead of submission.
+func hasSorobanOperation(envelope xdr.TransactionEnvelope) bool {
+	for _, op := range envelope.Operations() {
+		switch op.Body.Type {
+		case xdr.OperationTypeInvokeHostFunction, xdr.OperationTypeExtendFootprintTtl, xdr.OperationTypeRestoreFootprint:
+			return true
+		}
+	}
+	return false
+}
+
+// preflightRejection is returned by runPreflight when simulation surfaced a
+// definite failure that should short-circuit submission to hcnet-core.
+type preflightRejection struct {
+	reason              string
+	errorResultXDR      string
+	diagnosticEventsXDR []string
+}
+
+// runPreflight simulates envelope against the current ledger snapshot using
+// the same code path as simulateTransaction, reporting a preflightRejection
+// when the simulation surfaces a definite failure - insufficient resource
+// fee, footprint access to an expired entry, or a host-function trap.
+func runPreflight(ctx context.Context, preflightGetter preflight.Getter, envelope xdr.TransactionEnvelope) (*preflightRejection, error) {
+	result, err := preflightGetter.GetPreflight(ctx, envelope)
+	if err != nil {
+		return nil, err
+	}
+	if result.Error == "" {
+		return nil, nil
+	}
+
+	return &preflightRejection{
+		reason:              classifyPreflightError(result.Error),
+		errorResultXDR:      result.Error,
+		diagnosticEventsXDR: result.DiagnosticEventsXDR,
+	}, nil
+}
+
+// classifyPreflightError derives a low-cardinality reason label for the
+// txsub_preflight_rejections_total metric from the simulation's error.
+func classifyPreflightError(errorResultXDR string) string {
+	switch {
+	case strings.Contains(errorResultXDR, "trapped"):
+		return "host_function_trap"
+	case strings.Contains(errorResultXDR, "resource"):
+		return "insufficient_resource_fee"
+	case strings.Contains(errorResultXDR, "expired"):
+		return "expired_footprint_entry"
+	default:
+		return "other"
+	}
+}
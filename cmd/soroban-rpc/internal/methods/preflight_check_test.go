@@ -0,0 +1,21 @@
+package methods
+
+import "testing"
+
+func TestClassifyPreflightError(t *testing.T) {
+	cases := []struct {
+		errorResultXDR string
+		want           string
+	}{
+		{"host invocation trapped", "host_function_trap"},
+		{"insufficient resource fee", "insufficient_resource_fee"},
+		{"footprint entry expired", "expired_footprint_entry"},
+		{"something else entirely", "other"},
+	}
+
+	for _, c := range cases {
+		if got := classifyPreflightError(c.errorResultXDR); got != c.want {
+			t.Errorf("classifyPreflightError(%q) = %q, want %q", c.errorResultXDR, got, c.want)
+		}
+	}
+}
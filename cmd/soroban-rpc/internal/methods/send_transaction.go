@@ -5,12 +5,16 @@ import (
 	"encoding/hex"
 
 	"github.com/creachadair/jrpc2"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
 	"github.com/HashCash-Consultants/go/network"
 	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
 	"github.com/HashCash-Consultants/go/support/log"
 	"github.com/HashCash-Consultants/go/xdr"
 
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon"
 	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon/interfaces"
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/preflight"
 )
 
 // SendTransactionResponse represents the transaction submission response returned Soroban-RPC
@@ -43,10 +47,28 @@ type SendTransactionRequest struct {
 	Transaction string `json:"transaction"`
 }
 
-// NewSendTransactionHandler returns a submit transaction json rpc handler
-func NewSendTransactionHandler(daemon interfaces.Daemon, logger *log.Entry, ledgerRangeGetter LedgerRangeGetter, passphrase string) jrpc2.Handler {
-	submitter := daemon.CoreClient()
+// NewSendTransactionHandler returns a submit transaction json rpc handler.
+// When preflightMode is not PreflightModeOff, Soroban transactions are
+// simulated against the current ledger snapshot before being forwarded to
+// hcnet-core; in PreflightModeReject a definite simulation failure returns a
+// synthetic ERROR response without contacting core at all. Every status this
+// handler learns about synchronously from hcnet-core is also pushed to
+// subscriptionManager, so a concurrent subscribeTransactionStatus caller
+// does not have to wait for ledger ingestion to see PENDING/DUPLICATE/
+// TRY_AGAIN_LATER/ERROR.
+func NewSendTransactionHandler(d interfaces.Daemon, logger *log.Entry, ledgerRangeGetter LedgerRangeGetter, passphrase string, mempool *daemon.Mempool, subscriptionManager *SubscriptionManager, preflightGetter preflight.Getter, preflightMode PreflightMode) jrpc2.Handler {
+	submitter := d.CoreClient()
+
+	preflightRejectionsMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: d.MetricsNamespace(), Subsystem: "txsub", Name: "preflight_rejections_total",
+		Help: "number of transactions for which preflight simulation predicted a failure; in reject mode these are not forwarded to hcnet-core",
+	}, []string{"reason"})
+	d.MetricsRegistry().MustRegister(preflightRejectionsMetric)
+
 	return NewHandler(func(ctx context.Context, request SendTransactionRequest) (SendTransactionResponse, error) {
+		ctx, span := daemon.StartSpan(ctx, "NewSendTransactionHandler")
+		defer span.End()
+
 		var envelope xdr.TransactionEnvelope
 		err := xdr.SafeUnmarshalBase64(request.Transaction, &envelope)
 		if err != nil {
@@ -65,22 +87,68 @@ func NewSendTransactionHandler(daemon interfaces.Daemon, logger *log.Entry, ledg
 			}
 		}
 		txHash := hex.EncodeToString(hash[:])
-
 		latestLedgerInfo := ledgerRangeGetter.GetLedgerRange().LastLedger
+		span.SetAttributes(
+			attribute.String("tx_hash", txHash),
+			attribute.Int64("latest_ledger", int64(latestLedgerInfo.Sequence)),
+		)
+
+		if existing, ok := mempool.Get(txHash); ok {
+			return SendTransactionResponse{
+				Status:                existing.LastStatus,
+				Hash:                  txHash,
+				LatestLedger:          latestLedgerInfo.Sequence,
+				LatestLedgerCloseTime: latestLedgerInfo.CloseTime,
+			}, nil
+		}
+
+		if preflightMode != PreflightModeOff && hasSorobanOperation(envelope) {
+			rejection, preflightErr := runPreflight(ctx, preflightGetter, envelope)
+			if preflightErr != nil {
+				logger.WithError(preflightErr).WithField("tx", txHash).Debug("could not run preflight simulation")
+			} else if rejection != nil {
+				preflightRejectionsMetric.WithLabelValues(rejection.reason).Inc()
+				logger.WithField("tx", txHash).
+					WithField("reason", rejection.reason).
+					Info("preflight simulation predicts transaction will fail")
+				if preflightMode == PreflightModeReject {
+					subscriptionManager.NotifyStatusChange(TransactionStatusNotification{
+						Status:                proto.TXStatusError,
+						Hash:                  txHash,
+						LatestLedger:          latestLedgerInfo.Sequence,
+						LatestLedgerCloseTime: latestLedgerInfo.CloseTime,
+						ResultXDR:             rejection.errorResultXDR,
+						DiagnosticEventsXDR:   rejection.diagnosticEventsXDR,
+					})
+					return SendTransactionResponse{
+						ErrorResultXDR:        rejection.errorResultXDR,
+						DiagnosticEventsXDR:   rejection.diagnosticEventsXDR,
+						Status:                proto.TXStatusError,
+						Hash:                  txHash,
+						LatestLedger:          latestLedgerInfo.Sequence,
+						LatestLedgerCloseTime: latestLedgerInfo.CloseTime,
+					}, nil
+				}
+			}
+		}
+
 		resp, err := submitter.SubmitTransaction(ctx, request.Transaction)
 		if err != nil {
 			logger.WithError(err).
-				WithField("tx", request.Transaction).Error("could not submit transaction")
+				WithField("tx", request.Transaction).
+				WithField("backend", resp.Backend).Error("could not submit transaction")
 			return SendTransactionResponse{}, &jrpc2.Error{
 				Code:    jrpc2.InternalError,
 				Message: "could not submit transaction to hcnet-core",
 			}
 		}
+		logger.WithField("tx", txHash).WithField("backend", resp.Backend).Debug("submitted transaction")
 
 		// interpret response
 		if resp.IsException() {
 			logger.WithField("exception", resp.Exception).
-				WithField("tx", request.Transaction).Error("received exception from hcnet core")
+				WithField("tx", request.Transaction).
+				WithField("backend", resp.Backend).Error("received exception from hcnet core")
 			return SendTransactionResponse{}, &jrpc2.Error{
 				Code:    jrpc2.InternalError,
 				Message: "received exception from hcnet-core",
@@ -97,6 +165,14 @@ func NewSendTransactionHandler(daemon interfaces.Daemon, logger *log.Entry, ledg
 					Message: "could not decode diagnostic events",
 				}
 			}
+			subscriptionManager.NotifyStatusChange(TransactionStatusNotification{
+				Status:                resp.Status,
+				Hash:                  txHash,
+				LatestLedger:          latestLedgerInfo.Sequence,
+				LatestLedgerCloseTime: latestLedgerInfo.CloseTime,
+				ResultXDR:             resp.Error,
+				DiagnosticEventsXDR:   events,
+			})
 			return SendTransactionResponse{
 				ErrorResultXDR:        resp.Error,
 				DiagnosticEventsXDR:   events,
@@ -106,6 +182,13 @@ func NewSendTransactionHandler(daemon interfaces.Daemon, logger *log.Entry, ledg
 				LatestLedgerCloseTime: latestLedgerInfo.CloseTime,
 			}, nil
 		case proto.TXStatusPending, proto.TXStatusDuplicate, proto.TXStatusTryAgainLater:
+			mempool.Track(txHash, request.Transaction, envelope, resp.TXResponse, latestLedgerInfo.Sequence)
+			subscriptionManager.NotifyStatusChange(TransactionStatusNotification{
+				Status:                resp.Status,
+				Hash:                  txHash,
+				LatestLedger:          latestLedgerInfo.Sequence,
+				LatestLedgerCloseTime: latestLedgerInfo.CloseTime,
+			})
 			return SendTransactionResponse{
 				Status:                resp.Status,
 				Hash:                  txHash,
@@ -0,0 +1,252 @@
+package methods
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	"github.com/creachadair/jrpc2"
+	proto "github.com/HashCash-Consultants/go/protocols/hcnetcore"
+	"github.com/HashCash-Consultants/go/support/log"
+
+	"github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon"
+)
+
+// TransactionStatusNotification is the payload pushed to subscribers of
+// subscribeTransactionStatus whenever the status of a transaction changes,
+// including the initial notification sent upon subscribing.
+type TransactionStatusNotification struct {
+	// Status is one of proto.TXStatusPending, proto.TXStatusDuplicate,
+	// proto.TXStatusTryAgainLater, proto.TXStatusError, "NOT_FOUND",
+	// "SUCCESS", or "FAILED".
+	Status string `json:"status"`
+	// Hash is the hash of the transaction this notification concerns.
+	Hash string `json:"hash"`
+	// LatestLedger is the latest ledger known to Soroban-RPC at the time
+	// this notification was emitted.
+	LatestLedger uint32 `json:"latestLedger"`
+	// LatestLedgerCloseTime is the unix timestamp of the close time of
+	// LatestLedger.
+	LatestLedgerCloseTime int64 `json:"latestLedgerCloseTime,string"`
+	// ResultXDR is present for the ERROR status, holding the error result
+	// sendTransaction reported, and for the terminal SUCCESS/FAILED statuses,
+	// holding the base64-encoded TransactionResult.
+	ResultXDR string `json:"resultXdr,omitempty"`
+	// DiagnosticEventsXDR is a base64-encoded slice of xdr.DiagnosticEvent,
+	// present for ERROR and FAILED statuses.
+	DiagnosticEventsXDR []string `json:"diagnosticEventsXdr,omitempty"`
+}
+
+// Terminal/observed statuses which are not reported directly by hcnet-core's
+// SubmitTransaction response, but are instead derived by observing ingested
+// ledgers.
+const (
+	TXStatusNotFound = "NOT_FOUND"
+	TXStatusSuccess  = "SUCCESS"
+	TXStatusFailed   = "FAILED"
+)
+
+// subscription is a single subscribeTransactionStatus subscription: notifyCh
+// receives status pushes, and stopCh is closed by Unsubscribe to tell the
+// handler goroutine to stop forwarding even though the connection (and thus
+// ctx) is still open.
+type subscription struct {
+	notifyCh chan<- TransactionStatusNotification
+	stopCh   chan struct{}
+}
+
+// SubscriptionManager tracks live subscribeTransactionStatus subscriptions,
+// keyed by transaction hash, and fans out status transitions observed by
+// the ledger ingestion pipeline to every interested subscriber. It is safe
+// for concurrent use.
+type SubscriptionManager struct {
+	lock             sync.Mutex
+	maxSubscriptions uint
+	subscriptionsBy  map[string]map[uint64]*subscription
+	nextID           uint64
+}
+
+// NewSubscriptionManager creates a SubscriptionManager which rejects new
+// subscriptions once maxSubscriptions concurrent subscriptions are active.
+func NewSubscriptionManager(maxSubscriptions uint) *SubscriptionManager {
+	return &SubscriptionManager{
+		maxSubscriptions: maxSubscriptions,
+		subscriptionsBy:  make(map[string]map[uint64]*subscription),
+	}
+}
+
+// ErrTooManySubscriptions is returned by Subscribe once maxSubscriptions is reached.
+var ErrTooManySubscriptions = &jrpc2.Error{Code: jrpc2.InvalidRequest, Message: "too many concurrent subscriptions"}
+
+// Subscribe registers notifyCh to receive status updates for the transaction
+// identified by hash. It returns an opaque subscription id - to be returned
+// to the caller so a later unsubscribeTransactionStatus call can identify
+// it - and a stopCh which Unsubscribe closes to signal the caller's
+// forwarding loop to exit even if its connection stays open.
+func (m *SubscriptionManager) Subscribe(hash string, notifyCh chan<- TransactionStatusNotification) (id uint64, stopCh <-chan struct{}, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var total uint
+	for _, subs := range m.subscriptionsBy {
+		total += uint(len(subs))
+	}
+	if total >= m.maxSubscriptions {
+		return 0, nil, ErrTooManySubscriptions
+	}
+
+	m.nextID++
+	id = m.nextID
+	subs, ok := m.subscriptionsBy[hash]
+	if !ok {
+		subs = make(map[uint64]*subscription)
+		m.subscriptionsBy[hash] = subs
+	}
+	stop := make(chan struct{})
+	subs[id] = &subscription{notifyCh: notifyCh, stopCh: stop}
+	return id, stop, nil
+}
+
+// Unsubscribe removes the subscription with the given id for hash, if
+// present, and closes its stopCh so the handler goroutine which registered
+// it stops forwarding notifications. It is safe to call more than once for
+// the same (hash, id) pair; later calls are a no-op.
+func (m *SubscriptionManager) Unsubscribe(hash string, id uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	subs, ok := m.subscriptionsBy[hash]
+	if !ok {
+		return
+	}
+	sub, ok := subs[id]
+	if !ok {
+		return
+	}
+	delete(subs, id)
+	if len(subs) == 0 {
+		delete(m.subscriptionsBy, hash)
+	}
+	close(sub.stopCh)
+}
+
+// NotifyStatusChange fans a status transition out to every subscriber of
+// notification.Hash. It is invoked directly for the PENDING/DUPLICATE/
+// TRY_AGAIN_LATER/ERROR statuses hcnet-core reports synchronously from
+// NewSendTransactionHandler; the terminal SUCCESS/FAILED statuses instead
+// require the ledger ingestion pipeline to call this once it observes the
+// transaction applied.
+func (m *SubscriptionManager) NotifyStatusChange(notification TransactionStatusNotification) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, sub := range m.subscriptionsBy[notification.Hash] {
+		select {
+		case sub.notifyCh <- notification:
+		default:
+			// Slow subscriber: drop the notification rather than block ingestion.
+		}
+	}
+}
+
+// SubscribeTransactionStatusRequest is the request for subscribeTransactionStatus.
+type SubscribeTransactionStatusRequest struct {
+	// Hash is the hex-encoded hash of the transaction to watch.
+	Hash string `json:"hash"`
+}
+
+// SubscribeTransactionStatusResponse is the response to
+// subscribeTransactionStatus: the currently known status, plus the
+// subscription id a client must pass to unsubscribeTransactionStatus to
+// cancel the subscription before its connection closes.
+type SubscribeTransactionStatusResponse struct {
+	TransactionStatusNotification
+	// SubscriptionID identifies this subscription for a later
+	// unsubscribeTransactionStatus call.
+	SubscriptionID uint64 `json:"subscriptionId,string"`
+}
+
+// NewSubscribeTransactionStatusHandler returns a handler which, over a
+// WebSocket JSON-RPC connection, immediately reports the currently known
+// status of the transaction identified by Hash and then pushes a
+// transactionStatus notification for every status passed to
+// subscriptionManager.NotifyStatusChange for that hash, until the connection
+// closes, unsubscribeTransactionStatus is called, or an ERROR, SUCCESS or
+// FAILED notification has been pushed. Status changes produced by the local
+// mempool's automatic resubmissions are not pushed.
+func NewSubscribeTransactionStatusHandler(logger *log.Entry, mempool *daemon.Mempool, subscriptionManager *SubscriptionManager, ledgerRangeGetter LedgerRangeGetter) jrpc2.Handler {
+	return NewHandler(func(ctx context.Context, request SubscribeTransactionStatusRequest) (SubscribeTransactionStatusResponse, error) {
+		if _, err := hex.DecodeString(request.Hash); err != nil || len(request.Hash) != 64 {
+			return SubscribeTransactionStatusResponse{}, &jrpc2.Error{
+				Code:    jrpc2.InvalidParams,
+				Message: "invalid_hash",
+			}
+		}
+
+		latestLedgerInfo := ledgerRangeGetter.GetLedgerRange().LastLedger
+		initial := TransactionStatusNotification{
+			Status:                TXStatusNotFound,
+			Hash:                  request.Hash,
+			LatestLedger:          latestLedgerInfo.Sequence,
+			LatestLedgerCloseTime: latestLedgerInfo.CloseTime,
+		}
+		if entry, ok := mempool.Get(request.Hash); ok {
+			initial.Status = entry.LastStatus
+		} else {
+			logger.WithField("hash", request.Hash).Debug("no known submission status for transaction")
+		}
+
+		notifyCh := make(chan TransactionStatusNotification, 16)
+		subscriptionID, stopCh, err := subscriptionManager.Subscribe(request.Hash, notifyCh)
+		if err != nil {
+			return SubscribeTransactionStatusResponse{}, err
+		}
+
+		server := jrpc2.ServerFromContext(ctx)
+		go func() {
+			defer subscriptionManager.Unsubscribe(request.Hash, subscriptionID)
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-stopCh:
+					return
+				case notification := <-notifyCh:
+					if err := server.Notify(ctx, "transactionStatus", notification); err != nil {
+						logger.WithError(err).WithField("hash", request.Hash).Debug("could not push transaction status notification")
+						return
+					}
+					switch notification.Status {
+					case proto.TXStatusError, TXStatusSuccess, TXStatusFailed:
+						return
+					}
+				}
+			}
+		}()
+
+		return SubscribeTransactionStatusResponse{
+			TransactionStatusNotification: initial,
+			SubscriptionID:                subscriptionID,
+		}, nil
+	})
+}
+
+// UnsubscribeTransactionStatusRequest is the request for unsubscribeTransactionStatus.
+type UnsubscribeTransactionStatusRequest struct {
+	// Hash is the hex-encoded hash of the transaction to stop watching.
+	Hash string `json:"hash"`
+	// SubscriptionID is the id returned by the original
+	// subscribeTransactionStatus call.
+	SubscriptionID uint64 `json:"subscriptionId,string"`
+}
+
+// NewUnsubscribeTransactionStatusHandler returns a handler which cancels a
+// prior subscribeTransactionStatus subscription, removing it from
+// subscriptionManager and signalling its forwarding goroutine to stop even
+// though the connection stays open.
+func NewUnsubscribeTransactionStatusHandler(subscriptionManager *SubscriptionManager) jrpc2.Handler {
+	return NewHandler(func(_ context.Context, request UnsubscribeTransactionStatusRequest) (struct{}, error) {
+		subscriptionManager.Unsubscribe(request.Hash, request.SubscriptionID)
+		return struct{}{}, nil
+	})
+}
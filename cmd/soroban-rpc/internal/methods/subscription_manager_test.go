@@ -0,0 +1,69 @@
+package methods
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubscriptionManagerSubscribeAndNotify(t *testing.T) {
+	m := NewSubscriptionManager(10)
+	notifyCh := make(chan TransactionStatusNotification, 1)
+
+	id, stopCh, err := m.Subscribe("deadbeef", notifyCh)
+	if err != nil {
+		t.Fatalf("unexpected error from Subscribe: %v", err)
+	}
+
+	m.NotifyStatusChange(TransactionStatusNotification{Status: TXStatusSuccess, Hash: "deadbeef"})
+
+	select {
+	case n := <-notifyCh:
+		if n.Status != TXStatusSuccess {
+			t.Fatalf("expected SUCCESS notification, got %q", n.Status)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+
+	m.Unsubscribe("deadbeef", id)
+
+	select {
+	case <-stopCh:
+	default:
+		t.Fatal("expected Unsubscribe to close stopCh")
+	}
+
+	// Notifications after Unsubscribe must not be delivered to the (now
+	// removed) subscription.
+	m.NotifyStatusChange(TransactionStatusNotification{Status: TXStatusFailed, Hash: "deadbeef"})
+	select {
+	case n := <-notifyCh:
+		t.Fatalf("expected no further notifications after Unsubscribe, got %+v", n)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSubscriptionManagerUnsubscribeIsIdempotent(t *testing.T) {
+	m := NewSubscriptionManager(10)
+	notifyCh := make(chan TransactionStatusNotification, 1)
+
+	id, _, err := m.Subscribe("deadbeef", notifyCh)
+	if err != nil {
+		t.Fatalf("unexpected error from Subscribe: %v", err)
+	}
+
+	m.Unsubscribe("deadbeef", id)
+	m.Unsubscribe("deadbeef", id) // must not panic on double-close
+}
+
+func TestSubscriptionManagerRejectsOverMax(t *testing.T) {
+	m := NewSubscriptionManager(1)
+	notifyCh := make(chan TransactionStatusNotification, 1)
+
+	if _, _, err := m.Subscribe("hash-one", notifyCh); err != nil {
+		t.Fatalf("unexpected error from first Subscribe: %v", err)
+	}
+	if _, _, err := m.Subscribe("hash-two", notifyCh); err != ErrTooManySubscriptions {
+		t.Fatalf("expected ErrTooManySubscriptions, got %v", err)
+	}
+}
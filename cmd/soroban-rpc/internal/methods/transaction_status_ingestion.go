@@ -0,0 +1,44 @@
+package methods
+
+import "github.com/HashCash-Consultants/soroban-rpc/cmd/soroban-rpc/internal/daemon"
+
+// AppliedTransaction describes a single transaction the ledger ingestion
+// pipeline observed included in a closed ledger, in the shape
+// NotifyLedgerApplied needs to report its terminal subscribeTransactionStatus
+// notification.
+type AppliedTransaction struct {
+	// Hash is the transaction hash.
+	Hash string
+	// Successful is true if the transaction applied successfully.
+	Successful bool
+	// ResultXDR is the base64-encoded TransactionResult.
+	ResultXDR string
+	// DiagnosticEventsXDR is a base64-encoded slice of xdr.DiagnosticEvent,
+	// present when the transaction failed.
+	DiagnosticEventsXDR []string
+}
+
+// NotifyLedgerApplied is the ledger-ingestion hook for subscribeTransactionStatus
+// and the local mempool: call it once per ingested ledger with every
+// transaction that ledger applied, so SUCCESS/FAILED notifications reach
+// subscribers as soon as the transaction is observed closed (rather than
+// only the synchronous PENDING/DUPLICATE/TRY_AGAIN_LATER/ERROR statuses
+// NewSendTransactionHandler already pushes), and so mempool no longer
+// resubmits or fee-bumps an entry once it has applied.
+func NotifyLedgerApplied(subscriptionManager *SubscriptionManager, mempool *daemon.Mempool, ledgerSeq uint32, ledgerCloseTime int64, applied []AppliedTransaction) {
+	for _, tx := range applied {
+		status := TXStatusSuccess
+		if !tx.Successful {
+			status = TXStatusFailed
+		}
+		subscriptionManager.NotifyStatusChange(TransactionStatusNotification{
+			Status:                status,
+			Hash:                  tx.Hash,
+			LatestLedger:          ledgerSeq,
+			LatestLedgerCloseTime: ledgerCloseTime,
+			ResultXDR:             tx.ResultXDR,
+			DiagnosticEventsXDR:   tx.DiagnosticEventsXDR,
+		})
+		mempool.Resolve(tx.Hash)
+	}
+}